@@ -0,0 +1,36 @@
+// Package config defines the configuration used to set up an op-challenger instance.
+package config
+
+import (
+	"math/big"
+
+	"github.com/ethereum-optimism/optimism/op-service/txmgr"
+)
+
+// Config configures the behaviour of a single op-challenger instance.
+type Config struct {
+	// AgreeWithProposedOutput indicates whether this challenger agrees with the proposed L2
+	// output roots (and so defends games created against them) or disagrees (and so challenges
+	// them).
+	AgreeWithProposedOutput bool
+
+	// TxMgrConfig configures the transaction manager used to sign and submit claim moves.
+	TxMgrConfig txmgr.CLIConfig
+
+	// BondSponsorPrivateKey is the private key of a separate, pre-funded account used solely to
+	// cover the ETH bond attached to each claim move. If empty, the responder funds its own
+	// bonds from TxMgrConfig's account.
+	BondSponsorPrivateKey string
+	// BondSponsorEndpoint is the L1 RPC endpoint used for bond sponsor transactions and balance
+	// checks. If empty, TxMgrConfig's endpoint is reused.
+	BondSponsorEndpoint string
+	// MaxBondPerGame caps the bond the sponsor will front for a single game, so one misbehaving
+	// or adversarial game can't drain the cold wallet. Nil means no cap.
+	MaxBondPerGame *big.Int
+}
+
+// BondSponsorEnabled reports whether a separate account has been configured to fund claim bonds,
+// as opposed to the responder funding its own bonds.
+func (c *Config) BondSponsorEnabled() bool {
+	return c.BondSponsorPrivateKey != ""
+}
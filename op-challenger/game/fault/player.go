@@ -2,10 +2,14 @@ package fault
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sync"
+	"time"
 
 	"github.com/ethereum-optimism/optimism/op-challenger/config"
 	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/contracts"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/reorg"
 	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/responder"
 	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
 	gameTypes "github.com/ethereum-optimism/optimism/op-challenger/game/types"
@@ -24,12 +28,43 @@ type GameInfo interface {
 	GetClaimCount(context.Context) (uint64, error)
 }
 
+// bondReleaser is the subset of *responder.FaultResponder the player needs to stop counting a
+// resolved game's bonds as locked.
+type bondReleaser interface {
+	ReleaseAllBonds(ctx context.Context) error
+}
+
 type GamePlayer struct {
 	act                     actor
 	agreeWithProposedOutput bool
 	loader                  GameInfo
 	logger                  log.Logger
 	status                  gameTypes.GameStatus
+	client                  *ethclient.Client
+	detector                reorg.Detector
+	bonds                   bondReleaser
+	addr                    common.Address
+	m                       metrics.Metricer
+
+	// The fields below are retained (rather than only used inside NewGamePlayer) so that rebuild
+	// can reconstruct the trace provider, updater and responder stack from scratch after a reorg,
+	// instead of carrying forward state that may have been computed against a chain that no longer
+	// exists.
+	cfg          *config.Config
+	dir          string
+	txMgr        txmgr.TxManager
+	bondTxMgr    txmgr.TxManager
+	creator      resourceCreator
+	gameDepth    uint64
+	gameContract *contracts.FaultDisputeGameContract
+	// sponsor is created by the first rebuild and reused by later ones, so the game's locked bond
+	// total, and the per-game cap checked against it, carry across reorgs.
+	sponsor *responder.TxMgrBondSponsor
+
+	mu           sync.Mutex
+	actCancel    context.CancelFunc
+	lastObserved reorg.Cursor
+	dirty        bool // set by OnReorg; rebuild is required before the next act
 }
 
 type absolutePrestateValidator func(ctx context.Context, gameContract *contracts.FaultDisputeGameContract) error
@@ -44,8 +79,10 @@ func NewGamePlayer(
 	dir string,
 	addr common.Address,
 	txMgr txmgr.TxManager,
+	bondTxMgr txmgr.TxManager,
 	client *ethclient.Client,
 	creator resourceCreator,
+	detector reorg.Detector,
 ) (*GamePlayer, error) {
 	logger = logger.New("game", addr)
 	loader, err := contracts.NewFaultDisputeGameContract(addr, batching.NewMultiCaller(client.Client(), batching.DefaultBatchSize))
@@ -65,6 +102,9 @@ func NewGamePlayer(
 			loader:                  loader,
 			agreeWithProposedOutput: cfg.AgreeWithProposedOutput,
 			status:                  status,
+			client:                  client,
+			addr:                    addr,
+			m:                       m,
 			// Act function does nothing because the game is already complete
 			act: func(ctx context.Context) error {
 				return nil
@@ -77,27 +117,63 @@ func NewGamePlayer(
 		return nil, fmt.Errorf("failed to fetch the game depth: %w", err)
 	}
 
-	provider, updater, prestateValidator, err := creator(addr, gameDepth, dir)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create trace provider: %w", err)
+	g := &GamePlayer{
+		agreeWithProposedOutput: cfg.AgreeWithProposedOutput,
+		loader:                  loader,
+		logger:                  logger,
+		status:                  status,
+		client:                  client,
+		detector:                detector,
+		addr:                    addr,
+		m:                       m,
+		cfg:                     cfg,
+		dir:                     dir,
+		txMgr:                   txMgr,
+		bondTxMgr:               bondTxMgr,
+		creator:                 creator,
+		gameDepth:               gameDepth,
+		gameContract:            loader,
+	}
+	if err := g.rebuild(ctx); err != nil {
+		return nil, err
+	}
+	if detector != nil {
+		detector.Subscribe(addr, g)
 	}
+	return g, nil
+}
 
-	if err := prestateValidator(ctx, loader); err != nil {
-		return nil, fmt.Errorf("failed to validate absolute prestate: %w", err)
+// rebuild (re)creates the trace provider, oracle updater and responder this game acts through, and
+// installs the resulting agent as g.act. It is called once during construction and again whenever
+// a reorg has marked the game's previously built state as potentially stale, so that subsequent
+// actions run against trace data and a responder built fresh against the current canonical chain.
+func (g *GamePlayer) rebuild(ctx context.Context) error {
+	provider, updater, prestateValidator, err := g.creator(g.addr, g.gameDepth, g.dir)
+	if err != nil {
+		return fmt.Errorf("failed to create trace provider: %w", err)
+	}
+	if err := prestateValidator(ctx, g.gameContract); err != nil {
+		return fmt.Errorf("failed to validate absolute prestate: %w", err)
 	}
 
-	responder, err := responder.NewFaultResponder(logger, txMgr, addr)
+	var responderOpts []responder.Option
+	if g.cfg.BondSponsorEnabled() {
+		if g.bondTxMgr == nil {
+			return fmt.Errorf("bond sponsor is configured but no bond sponsor tx manager was provided")
+		}
+		if g.sponsor == nil {
+			g.sponsor = responder.NewTxMgrBondSponsor(g.logger, g.bondTxMgr, g.client, g.addr, g.txMgr.From(), g.cfg.MaxBondPerGame, g.m)
+		}
+		responderOpts = append(responderOpts, responder.WithBondSponsor(g.sponsor))
+	}
+	faultResponder, err := responder.NewFaultResponder(g.logger, g.txMgr, g.addr, g.gameContract, responderOpts...)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create the responder: %w", err)
+		return fmt.Errorf("failed to create the responder: %w", err)
 	}
 
-	return &GamePlayer{
-		act:                     NewAgent(m, loader, int(gameDepth), provider, responder, updater, cfg.AgreeWithProposedOutput, logger).Act,
-		agreeWithProposedOutput: cfg.AgreeWithProposedOutput,
-		loader:                  loader,
-		logger:                  logger,
-		status:                  status,
-	}, nil
+	g.act = NewAgent(g.m, g.loader, int(g.gameDepth), provider, faultResponder, updater, g.cfg.AgreeWithProposedOutput, g.logger).Act
+	g.bonds = faultResponder
+	return nil
 }
 
 func (g *GamePlayer) Status() gameTypes.GameStatus {
@@ -110,27 +186,109 @@ func (g *GamePlayer) ProgressGame(ctx context.Context) gameTypes.GameStatus {
 		g.logger.Trace("Skipping completed game")
 		return g.status
 	}
+	g.mu.Lock()
+	dirty := g.dirty
+	g.dirty = false
+	g.mu.Unlock()
+	if dirty && g.creator != nil {
+		g.logger.Info("Rebuilding game state after L1 reorg")
+		if err := g.rebuild(ctx); err != nil {
+			g.logger.Error("Failed to rebuild game state after reorg, will retry next time the game is progressed", "err", err)
+			g.mu.Lock()
+			g.dirty = true
+			g.mu.Unlock()
+			return gameTypes.GameStatusInProgress
+		}
+	}
 	g.logger.Trace("Checking if actions are required")
-	if err := g.act(ctx); err != nil {
-		g.logger.Error("Error when acting on game", "err", err)
+	actCtx, cancel := context.WithCancel(ctx)
+	g.mu.Lock()
+	g.actCancel = cancel
+	g.mu.Unlock()
+	start := time.Now()
+	err := g.act(actCtx)
+	g.m.RecordActDuration(g.addr, time.Since(start))
+	g.mu.Lock()
+	g.actCancel = nil
+	g.mu.Unlock()
+	cancel()
+	if err != nil {
+		if errors.Is(err, context.Canceled) && ctx.Err() == nil {
+			g.logger.Warn("Action cancelled by L1 reorg, will retry next time the game is progressed")
+			g.m.RecordActError(g.addr, "reorg_cancelled")
+		} else {
+			g.logger.Error("Error when acting on game", "err", err)
+			g.m.RecordActError(g.addr, "act_failed")
+		}
 	}
 	status, err := g.loader.GetStatus(ctx)
 	if err != nil {
 		g.logger.Warn("Unable to retrieve game status", "err", err)
 		return gameTypes.GameStatusInProgress
 	}
+	g.recordObservedHead(ctx)
 	g.logGameStatus(ctx, status)
 	g.status = status
+	if status != gameTypes.GameStatusInProgress {
+		if g.detector != nil {
+			// The game is resolved, so there's nothing left for a reorg to invalidate. Stop holding
+			// a reference to this (and everything it retains) for the lifetime of the detector.
+			g.detector.Unsubscribe(g.addr)
+		}
+		if g.bonds != nil {
+			// Any bond fronted for this game has now been returned on-chain, so it's no longer
+			// part of the fleet's real in-flight exposure.
+			if err := g.bonds.ReleaseAllBonds(ctx); err != nil {
+				g.logger.Warn("Failed to release bonds for resolved game", "err", err)
+			}
+		}
+	}
 	return status
 }
 
+// recordObservedHead records the L1 head this game's state was last read against, so a future
+// reorg event can tell whether anything this game observed is no longer canonical.
+func (g *GamePlayer) recordObservedHead(ctx context.Context) {
+	if g.client == nil {
+		return
+	}
+	head, err := g.client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		g.logger.Warn("Unable to record L1 head observed for game", "err", err)
+		return
+	}
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.lastObserved = reorg.Cursor{Number: head.Number.Uint64(), Hash: head.Hash()}
+}
+
+// OnReorg implements reorg.Subscriber. If this game last observed its state above the reorg's
+// common ancestor (or has not recorded an observation yet), that state may be stale: cancel any in-flight action and mark the game
+// dirty, so the next call to ProgressGame rebuilds the trace provider, updater and responder from
+// scratch against the new canonical chain before acting again, rather than reusing state computed
+// under the old one.
+func (g *GamePlayer) OnReorg(ctx context.Context, evt reorg.Event) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.lastObserved.Number != 0 && g.lastObserved.Number <= evt.CommonAncestor.Number {
+		return
+	}
+	g.logger.Warn("L1 reorg may have invalidated cached game state", "commonAncestor", evt.CommonAncestor.Number, "depth", evt.Depth)
+	g.dirty = true
+	if g.actCancel != nil {
+		g.actCancel()
+	}
+}
+
 func (g *GamePlayer) logGameStatus(ctx context.Context, status gameTypes.GameStatus) {
+	g.m.RecordGameStatus(g.addr, status)
 	if status == gameTypes.GameStatusInProgress {
 		claimCount, err := g.loader.GetClaimCount(ctx)
 		if err != nil {
 			g.logger.Error("Failed to get claim count for in progress game", "err", err)
 			return
 		}
+		g.m.RecordClaimCount(g.addr, claimCount)
 		g.logger.Info("Game info", "claims", claimCount, "status", status)
 		return
 	}
@@ -0,0 +1,183 @@
+package reorg
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// HeadSource is the subset of an L1 client the detector needs to follow the canonical chain.
+type HeadSource interface {
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+	SubscribeNewHead(ctx context.Context, ch chan<- *types.Header) (ethereum.Subscription, error)
+}
+
+// L1Detector is a Detector that follows an L1 client's head, comparing each new block against the
+// canonical hashes it previously recorded in store. A divergence means a reorg occurred: it walks
+// backwards along the (new) canonical chain until it finds a block number whose hash still
+// matches what was previously recorded there, treats that as the common ancestor, and notifies
+// subscribers so they can invalidate anything observed above it.
+type L1Detector struct {
+	log            log.Logger
+	source         HeadSource
+	store          CursorStore
+	metrics        Metrics
+	finalityWindow uint64
+
+	mu          sync.Mutex
+	subscribers map[common.Address]Subscriber
+}
+
+// NewDetector creates a detector that treats a reorg deeper than finalityWindow blocks as fatal,
+// since an ancestor that old is either a bug in the source or a reorg far deeper than this chain
+// is expected to ever produce.
+func NewDetector(logger log.Logger, source HeadSource, store CursorStore, m Metrics, finalityWindow uint64) *L1Detector {
+	return &L1Detector{
+		log:            logger,
+		source:         source,
+		store:          store,
+		metrics:        m,
+		finalityWindow: finalityWindow,
+		subscribers:    make(map[common.Address]Subscriber),
+	}
+}
+
+func (d *L1Detector) Subscribe(addr common.Address, sub Subscriber) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.subscribers[addr] = sub
+}
+
+func (d *L1Detector) Unsubscribe(addr common.Address) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	delete(d.subscribers, addr)
+}
+
+func (d *L1Detector) Start(ctx context.Context) error {
+	if err := d.catchUp(ctx); err != nil {
+		return fmt.Errorf("failed to catch up on reorgs missed while offline: %w", err)
+	}
+	headCh := make(chan *types.Header, 16)
+	sub, err := d.source.SubscribeNewHead(ctx, headCh)
+	if err != nil {
+		return fmt.Errorf("failed to subscribe to L1 heads: %w", err)
+	}
+	defer sub.Unsubscribe()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case err := <-sub.Err():
+			return fmt.Errorf("L1 head subscription failed: %w", err)
+		case head := <-headCh:
+			if err := d.onNewHead(ctx, head); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// catchUp checks on startup whether the L1 block at the persisted cursor is still canonical and,
+// if it is not, emits a synthetic reorg event so subscribers can invalidate anything stale before
+// they act. Only subscribers registered before Start is called receive that event.
+func (d *L1Detector) catchUp(ctx context.Context) error {
+	latest, ok, err := d.store.Latest()
+	if err != nil {
+		return fmt.Errorf("failed to load persisted cursor: %w", err)
+	}
+	if !ok {
+		return nil
+	}
+	header, err := d.source.HeaderByNumber(ctx, new(big.Int).SetUint64(latest.Number))
+	if err != nil {
+		return fmt.Errorf("failed to fetch header at persisted cursor %d: %w", latest.Number, err)
+	}
+	if header.Hash() == latest.Hash {
+		return nil
+	}
+	d.log.Warn("L1 reorged while challenger was offline", "persisted", latest.Number, "persistedHash", latest.Hash)
+	return d.handleDivergence(ctx, header)
+}
+
+func (d *L1Detector) onNewHead(ctx context.Context, head *types.Header) error {
+	latest, ok, err := d.store.Latest()
+	if err != nil {
+		return fmt.Errorf("failed to load cursor: %w", err)
+	}
+	if !ok || (head.ParentHash == latest.Hash && head.Number.Uint64() == latest.Number+1) {
+		if err := d.store.Put(head.Number.Uint64(), head.Hash()); err != nil {
+			return fmt.Errorf("failed to persist cursor for block %d: %w", head.Number.Uint64(), err)
+		}
+		return d.store.Prune(saturatingSub(head.Number.Uint64(), d.finalityWindow))
+	}
+	return d.handleDivergence(ctx, head)
+}
+
+// handleDivergence walks back from head along the current canonical chain until it finds a block
+// whose hash was already recorded for that number, treats that as the common ancestor, and
+// notifies subscribers.
+func (d *L1Detector) handleDivergence(ctx context.Context, head *types.Header) error {
+	ancestor, depth, err := d.findCommonAncestor(ctx, head)
+	if err != nil {
+		return err
+	}
+	d.log.Warn("Detected L1 reorg", "commonAncestor", ancestor.Number, "depth", depth)
+	if d.metrics != nil {
+		d.metrics.RecordReorgDepth(depth)
+	}
+	evt := Event{CommonAncestor: ancestor, Depth: depth}
+	d.mu.Lock()
+	affected := make([]Subscriber, 0, len(d.subscribers))
+	for _, sub := range d.subscribers {
+		affected = append(affected, sub)
+	}
+	d.mu.Unlock()
+	if d.metrics != nil {
+		d.metrics.RecordReorgAffectedGames(len(affected))
+	}
+	for _, sub := range affected {
+		sub.OnReorg(ctx, evt)
+	}
+	if err := d.store.Put(head.Number.Uint64(), head.Hash()); err != nil {
+		return fmt.Errorf("failed to persist post-reorg cursor: %w", err)
+	}
+	return d.store.Prune(saturatingSub(head.Number.Uint64(), d.finalityWindow))
+}
+
+func (d *L1Detector) findCommonAncestor(ctx context.Context, head *types.Header) (Cursor, uint64, error) {
+	number := head.Number.Uint64()
+	hash := head.Hash()
+	for depth := uint64(0); depth <= d.finalityWindow; depth++ {
+		recorded, ok, err := d.store.Hash(number)
+		if err != nil {
+			return Cursor{}, 0, fmt.Errorf("failed to read recorded hash for block %d: %w", number, err)
+		}
+		if ok && recorded == hash {
+			return Cursor{Number: number, Hash: hash}, depth, nil
+		}
+		if number == 0 {
+			break
+		}
+		number--
+		header, err := d.source.HeaderByNumber(ctx, new(big.Int).SetUint64(number))
+		if err != nil {
+			return Cursor{}, 0, fmt.Errorf("failed to fetch header %d while searching for common ancestor: %w", number, err)
+		}
+		hash = header.Hash()
+	}
+	return Cursor{}, 0, fmt.Errorf("%w: finality window is %d blocks", ErrAncestorNotFound, d.finalityWindow)
+}
+
+func saturatingSub(a, b uint64) uint64 {
+	if b > a {
+		return 0
+	}
+	return a - b
+}
@@ -0,0 +1,120 @@
+package reorg
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+type stubHeadSource struct {
+	headers map[uint64]*types.Header
+}
+
+func (s *stubHeadSource) HeaderByNumber(_ context.Context, number *big.Int) (*types.Header, error) {
+	header, ok := s.headers[number.Uint64()]
+	if !ok {
+		return nil, errors.New("no header at requested number")
+	}
+	return header, nil
+}
+
+func (s *stubHeadSource) SubscribeNewHead(context.Context, chan<- *types.Header) (ethereum.Subscription, error) {
+	return nil, errors.New("not implemented")
+}
+
+func newTestDetector(t *testing.T, source HeadSource, finalityWindow uint64) (*L1Detector, CursorStore) {
+	store, err := NewBoltCursorStore(filepath.Join(t.TempDir(), "reorg.db"))
+	require.NoError(t, err)
+	return NewDetector(log.New(), source, store, nil, finalityWindow), store
+}
+
+func TestFindCommonAncestor(t *testing.T) {
+	oldHeader8 := &types.Header{Number: big.NewInt(8)}
+	oldHash8 := oldHeader8.Hash()
+	oldHeader9 := &types.Header{Number: big.NewInt(9), ParentHash: oldHash8}
+	oldHash9 := oldHeader9.Hash()
+	oldHeader10 := &types.Header{Number: big.NewInt(10), ParentHash: oldHash9}
+	oldHash10 := oldHeader10.Hash()
+
+	newHeader9 := &types.Header{Number: big.NewInt(9), ParentHash: oldHash8, Extra: []byte("fork")}
+	newHeader10 := &types.Header{Number: big.NewInt(10), ParentHash: newHeader9.Hash()}
+
+	source := &stubHeadSource{headers: map[uint64]*types.Header{
+		8: oldHeader8,
+		9: newHeader9,
+	}}
+	d, store := newTestDetector(t, source, 10)
+	require.NoError(t, store.Put(8, oldHash8))
+	require.NoError(t, store.Put(9, oldHash9))
+	require.NoError(t, store.Put(10, oldHash10))
+
+	ancestor, depth, err := d.findCommonAncestor(context.Background(), newHeader10)
+	require.NoError(t, err)
+	require.Equal(t, Cursor{Number: 8, Hash: oldHash8}, ancestor)
+	require.Equal(t, uint64(2), depth)
+}
+
+func TestFindCommonAncestor_NoMatchWithinFinalityWindow(t *testing.T) {
+	header0 := &types.Header{Number: big.NewInt(0)}
+	newHead := &types.Header{Number: big.NewInt(0), Extra: []byte("fork")}
+
+	source := &stubHeadSource{headers: map[uint64]*types.Header{0: header0}}
+	d, store := newTestDetector(t, source, 0)
+	require.NoError(t, store.Put(0, header0.Hash()))
+
+	_, _, err := d.findCommonAncestor(context.Background(), newHead)
+	require.ErrorIs(t, err, ErrAncestorNotFound)
+}
+
+func TestOnNewHead_NoDivergencePersistsCursor(t *testing.T) {
+	parent := &types.Header{Number: big.NewInt(1)}
+	head := &types.Header{Number: big.NewInt(2), ParentHash: parent.Hash()}
+
+	source := &stubHeadSource{}
+	d, store := newTestDetector(t, source, 10)
+	require.NoError(t, store.Put(1, parent.Hash()))
+
+	require.NoError(t, d.onNewHead(context.Background(), head))
+
+	latest, found, err := store.Latest()
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, Cursor{Number: 2, Hash: head.Hash()}, latest)
+}
+
+type recordingSubscriber struct {
+	events []Event
+}
+
+func (r *recordingSubscriber) OnReorg(_ context.Context, evt Event) {
+	r.events = append(r.events, evt)
+}
+
+func TestOnNewHead_DivergenceNotifiesSubscribers(t *testing.T) {
+	oldHeader1 := &types.Header{Number: big.NewInt(1)}
+	oldHash1 := oldHeader1.Hash()
+	oldHeader2 := &types.Header{Number: big.NewInt(2), ParentHash: oldHash1}
+
+	newHead := &types.Header{Number: big.NewInt(2), ParentHash: common.Hash{}, Extra: []byte("fork")}
+
+	source := &stubHeadSource{headers: map[uint64]*types.Header{1: oldHeader1}}
+	d, store := newTestDetector(t, source, 10)
+	require.NoError(t, store.Put(1, oldHash1))
+	require.NoError(t, store.Put(2, oldHeader2.Hash()))
+
+	sub := &recordingSubscriber{}
+	d.Subscribe(common.HexToAddress("0x1"), sub)
+
+	require.NoError(t, d.onNewHead(context.Background(), newHead))
+
+	require.Len(t, sub.events, 1)
+	require.Equal(t, Cursor{Number: 1, Hash: oldHash1}, sub.events[0].CommonAncestor)
+}
@@ -0,0 +1,57 @@
+// Package reorg detects L1 chain reorganizations that could invalidate state the challenger has
+// already cached about a game's claims, and notifies interested subscribers so they can refresh
+// that state (or abandon an in-flight action) before acting on stale assumptions.
+package reorg
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ErrAncestorNotFound is returned when the detector walks back past its configured finality
+// window without finding a block that is still part of the canonical chain. Callers should treat
+// this as fatal: silently picking an older ancestor risks invalidating state that was in fact
+// still valid, or missing a reorg that goes deeper than assumed finality.
+var ErrAncestorNotFound = errors.New("reorg: no common ancestor found within finality window")
+
+// Cursor identifies a single L1 block by number and hash.
+type Cursor struct {
+	Number uint64
+	Hash   common.Hash
+}
+
+// Event reports that the canonical L1 chain diverged from what was previously observed.
+type Event struct {
+	// CommonAncestor is the highest L1 block that both the old and new canonical chains agree on.
+	// Anything observed at a block number above this may no longer be canonical.
+	CommonAncestor Cursor
+	// Depth is how many blocks the new head is above CommonAncestor.
+	Depth uint64
+}
+
+// Subscriber is notified when a reorg may have invalidated state it previously observed above
+// evt.CommonAncestor.Number.
+type Subscriber interface {
+	OnReorg(ctx context.Context, evt Event)
+}
+
+// Detector watches the L1 head and detects when the canonical chain has reorganized, notifying
+// subscribers so they can invalidate cached state and re-fetch it from the (new) canonical chain.
+type Detector interface {
+	// Subscribe registers sub to be notified of reorgs under addr. Subscribing again with the
+	// same addr replaces the earlier subscriber.
+	Subscribe(addr common.Address, sub Subscriber)
+	// Unsubscribe removes a previously registered subscriber.
+	Unsubscribe(addr common.Address)
+	// Start begins following the L1 head, first catching up on any reorg that happened while the
+	// process was not running. It blocks until ctx is done or an unrecoverable error occurs.
+	Start(ctx context.Context) error
+}
+
+// Metrics is the subset of metrics.Metricer the detector needs to report reorg activity.
+type Metrics interface {
+	RecordReorgDepth(depth uint64)
+	RecordReorgAffectedGames(count int)
+}
@@ -0,0 +1,112 @@
+package reorg
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	bolt "go.etcd.io/bbolt"
+)
+
+var cursorBucket = []byte("canonical-cursor")
+
+// CursorStore persists the canonical hash recorded for recent L1 blocks, so the detector can
+// walk back through them after a restart without re-deriving them from L1. Entries older than
+// the detector's finality window are pruned as new ones are recorded.
+type CursorStore interface {
+	// Put records that number is (now) canonical with the given hash.
+	Put(number uint64, hash common.Hash) error
+	// Hash returns the previously recorded canonical hash for number, if any.
+	Hash(number uint64) (common.Hash, bool, error)
+	// Latest returns the highest number that has been recorded.
+	Latest() (Cursor, bool, error)
+	// Prune removes all recorded entries older than minNumber.
+	Prune(minNumber uint64) error
+}
+
+// boltCursorStore is a CursorStore backed by a bolt database, so a challenger restart after a
+// reorg still notices it missed one instead of silently trusting whatever L1 now reports.
+type boltCursorStore struct {
+	db *bolt.DB
+}
+
+// NewBoltCursorStore opens (creating if necessary) a bolt-backed CursorStore at path.
+func NewBoltCursorStore(path string) (CursorStore, error) {
+	db, err := bolt.Open(path, 0o600, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open reorg cursor store at %v: %w", path, err)
+	}
+	if err := db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(cursorBucket)
+		return err
+	}); err != nil {
+		return nil, fmt.Errorf("failed to initialize reorg cursor store: %w", err)
+	}
+	return &boltCursorStore{db: db}, nil
+}
+
+func (s *boltCursorStore) Put(number uint64, hash common.Hash) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(cursorBucket).Put(encodeNumber(number), hash.Bytes())
+	})
+}
+
+func (s *boltCursorStore) Hash(number uint64) (common.Hash, bool, error) {
+	var hash common.Hash
+	found := false
+	err := s.db.View(func(tx *bolt.Tx) error {
+		v := tx.Bucket(cursorBucket).Get(encodeNumber(number))
+		if v == nil {
+			return nil
+		}
+		hash = common.BytesToHash(v)
+		found = true
+		return nil
+	})
+	if err != nil {
+		return common.Hash{}, false, fmt.Errorf("failed to read cursor for block %d: %w", number, err)
+	}
+	return hash, found, nil
+}
+
+func (s *boltCursorStore) Latest() (Cursor, bool, error) {
+	var cursor Cursor
+	found := false
+	err := s.db.View(func(tx *bolt.Tx) error {
+		k, v := tx.Bucket(cursorBucket).Cursor().Last()
+		if k == nil {
+			return nil
+		}
+		cursor = Cursor{Number: decodeNumber(k), Hash: common.BytesToHash(v)}
+		found = true
+		return nil
+	})
+	if err != nil {
+		return Cursor{}, false, fmt.Errorf("failed to read latest cursor: %w", err)
+	}
+	return cursor, found, nil
+}
+
+func (s *boltCursorStore) Prune(minNumber uint64) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		c := tx.Bucket(cursorBucket).Cursor()
+		for k, _ := c.First(); k != nil && decodeNumber(k) < minNumber; k, _ = c.Next() {
+			// Deleting via the cursor that's driving the iteration is bbolt's documented-safe
+			// pattern; deleting through the bucket directly can skip entries after a rebalance.
+			if err := c.Delete(); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func encodeNumber(number uint64) []byte {
+	buf := make([]byte, 8)
+	binary.BigEndian.PutUint64(buf, number)
+	return buf
+}
+
+func decodeNumber(buf []byte) uint64 {
+	return binary.BigEndian.Uint64(buf)
+}
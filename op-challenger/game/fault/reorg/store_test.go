@@ -0,0 +1,78 @@
+package reorg
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func setupStore(t *testing.T) CursorStore {
+	dir := t.TempDir()
+	store, err := NewBoltCursorStore(filepath.Join(dir, "reorg.db"))
+	require.NoError(t, err)
+	return store
+}
+
+func TestBoltCursorStore_PutAndHash(t *testing.T) {
+	store := setupStore(t)
+	hash := common.HexToHash("0x01")
+
+	_, found, err := store.Hash(5)
+	require.NoError(t, err)
+	require.False(t, found)
+
+	require.NoError(t, store.Put(5, hash))
+
+	got, found, err := store.Hash(5)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, hash, got)
+}
+
+func TestBoltCursorStore_Latest(t *testing.T) {
+	store := setupStore(t)
+
+	_, found, err := store.Latest()
+	require.NoError(t, err)
+	require.False(t, found)
+
+	require.NoError(t, store.Put(5, common.HexToHash("0x05")))
+	require.NoError(t, store.Put(10, common.HexToHash("0x10")))
+	require.NoError(t, store.Put(7, common.HexToHash("0x07")))
+
+	latest, found, err := store.Latest()
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, Cursor{Number: 10, Hash: common.HexToHash("0x10")}, latest)
+}
+
+func TestBoltCursorStore_Prune(t *testing.T) {
+	store := setupStore(t)
+	require.NoError(t, store.Put(1, common.HexToHash("0x01")))
+	require.NoError(t, store.Put(2, common.HexToHash("0x02")))
+	require.NoError(t, store.Put(3, common.HexToHash("0x03")))
+
+	require.NoError(t, store.Prune(3))
+
+	for _, number := range []uint64{1, 2} {
+		_, found, err := store.Hash(number)
+		require.NoError(t, err)
+		require.Falsef(t, found, "expected block %d to have been pruned", number)
+	}
+	_, found, err := store.Hash(3)
+	require.NoError(t, err)
+	require.True(t, found, "expected block at the prune boundary to be retained")
+}
+
+func TestBoltCursorStore_PruneNothingBelowThreshold(t *testing.T) {
+	store := setupStore(t)
+	require.NoError(t, store.Put(10, common.HexToHash("0x10")))
+
+	require.NoError(t, store.Prune(0))
+
+	_, found, err := store.Hash(10)
+	require.NoError(t, err)
+	require.True(t, found)
+}
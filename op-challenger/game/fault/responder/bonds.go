@@ -0,0 +1,126 @@
+package responder
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
+	"github.com/ethereum-optimism/optimism/op-service/txmgr"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// BalanceSource is the subset of an L1 client the bond sponsor needs to check its own balance
+// before committing to fund a bond.
+type BalanceSource interface {
+	BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error)
+}
+
+// Metrics is the subset of metrics.Metricer a BondSponsor needs to report its locked balance.
+type Metrics interface {
+	RecordBondsLocked(addr common.Address, amount *big.Int)
+}
+
+// TxMgrBondSponsor is the default BondSponsor. It funds claim bonds from a separately configured
+// txmgr.TxManager (a cold wallet) rather than the responder's own hot key, so compromising the
+// hot key can't drain the bonded balance. One TxMgrBondSponsor is created per game, so
+// maxBondPerGame bounds the cumulative amount it will ever have locked at once, regardless of how
+// many moves that game's responder makes.
+type TxMgrBondSponsor struct {
+	log            log.Logger
+	txMgr          txmgr.TxManager
+	balances       BalanceSource
+	game           common.Address
+	responder      common.Address
+	maxBondPerGame *big.Int
+	metrics        Metrics
+
+	mu     sync.Mutex
+	locked *big.Int // total currently fronted for this game, across every move made so far
+}
+
+// NewTxMgrBondSponsor creates a BondSponsor that sends funding transactions via txMgr to
+// responder, rejecting any funding request that would bring the game's cumulative locked bond
+// above maxBondPerGame (nil means no cap). game identifies the game this sponsor is funding bonds
+// for, so its locked total can be reported per-game; the fleet-wide total is the sum of that
+// series across every game's sponsor.
+func NewTxMgrBondSponsor(logger log.Logger, txMgr txmgr.TxManager, balances BalanceSource, game, responder common.Address, maxBondPerGame *big.Int, m Metrics) *TxMgrBondSponsor {
+	return &TxMgrBondSponsor{
+		log:            logger,
+		txMgr:          txMgr,
+		balances:       balances,
+		game:           game,
+		responder:      responder,
+		maxBondPerGame: maxBondPerGame,
+		metrics:        m,
+		locked:         big.NewInt(0),
+	}
+}
+
+func (s *TxMgrBondSponsor) Fund(ctx context.Context, parent types.Claim, bondAmount *big.Int) error {
+	s.mu.Lock()
+	prospective := new(big.Int).Add(s.locked, bondAmount)
+	s.mu.Unlock()
+	if s.maxBondPerGame != nil && prospective.Cmp(s.maxBondPerGame) > 0 {
+		return fmt.Errorf("funding %v would bring this game's locked bond to %v, exceeding the configured max of %v", bondAmount, prospective, s.maxBondPerGame)
+	}
+	balance, err := s.balances.BalanceAt(ctx, s.txMgr.From(), nil)
+	if err != nil {
+		return fmt.Errorf("failed to check bond sponsor balance: %w", err)
+	}
+	if balance.Cmp(bondAmount) < 0 {
+		return fmt.Errorf("bond sponsor balance %v is below the required bond %v", balance, bondAmount)
+	}
+	candidate := txmgr.TxCandidate{
+		To:    &s.responder,
+		Value: bondAmount,
+	}
+	if _, err := s.txMgr.Send(ctx, candidate); err != nil {
+		return fmt.Errorf("failed to send bond funding tx: %w", err)
+	}
+	s.mu.Lock()
+	s.locked.Add(s.locked, bondAmount)
+	s.mu.Unlock()
+	s.recordLocked()
+	return nil
+}
+
+// Withdraw releases bondAmount that was fronted for parent but never spent, e.g. because the move
+// it was meant for was never submitted. The ETH itself remains in the responder's account;
+// reclaiming it back to the cold wallet is a separate, out-of-band sweep, since only the
+// responder's own key can move funds out of its account.
+func (s *TxMgrBondSponsor) Withdraw(ctx context.Context, parent types.Claim, bondAmount *big.Int) error {
+	s.mu.Lock()
+	s.locked.Sub(s.locked, bondAmount)
+	if s.locked.Sign() < 0 {
+		s.locked.SetInt64(0)
+	}
+	s.mu.Unlock()
+	s.recordLocked()
+	return nil
+}
+
+// ReleaseAll stops counting any bond this sponsor has fronted as locked, because the game it was
+// funding has resolved and every bond it posted has since been returned to the responder.
+func (s *TxMgrBondSponsor) ReleaseAll(ctx context.Context) error {
+	s.mu.Lock()
+	s.locked.SetInt64(0)
+	s.mu.Unlock()
+	s.recordLocked()
+	return nil
+}
+
+func (s *TxMgrBondSponsor) Locked() *big.Int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return new(big.Int).Set(s.locked)
+}
+
+func (s *TxMgrBondSponsor) recordLocked() {
+	if s.metrics == nil {
+		return
+	}
+	s.metrics.RecordBondsLocked(s.game, s.Locked())
+}
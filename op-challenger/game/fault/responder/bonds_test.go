@@ -0,0 +1,120 @@
+package responder
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
+	"github.com/ethereum-optimism/optimism/op-service/txmgr"
+	"github.com/ethereum/go-ethereum/common"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+type stubBalanceSource struct {
+	balance *big.Int
+}
+
+func (s *stubBalanceSource) BalanceAt(_ context.Context, _ common.Address, _ *big.Int) (*big.Int, error) {
+	return s.balance, nil
+}
+
+type stubTxManager struct {
+	from common.Address
+	sent []txmgr.TxCandidate
+}
+
+func (s *stubTxManager) From() common.Address {
+	return s.from
+}
+
+func (s *stubTxManager) Send(_ context.Context, candidate txmgr.TxCandidate) (*gethtypes.Receipt, error) {
+	s.sent = append(s.sent, candidate)
+	return &gethtypes.Receipt{}, nil
+}
+
+type stubMetrics struct {
+	locked map[common.Address]*big.Int
+}
+
+func newStubMetrics() *stubMetrics {
+	return &stubMetrics{locked: make(map[common.Address]*big.Int)}
+}
+
+func (s *stubMetrics) RecordBondsLocked(addr common.Address, amount *big.Int) {
+	s.locked[addr] = amount
+}
+
+func newTestSponsor(t *testing.T, balance, maxBondPerGame *big.Int, m Metrics) (*TxMgrBondSponsor, *stubTxManager) {
+	txMgr := &stubTxManager{from: common.HexToAddress("0xf00d")}
+	sponsor := NewTxMgrBondSponsor(log.New(), txMgr, &stubBalanceSource{balance: balance}, common.HexToAddress("0x1"), common.HexToAddress("0x2"), maxBondPerGame, m)
+	return sponsor, txMgr
+}
+
+func TestTxMgrBondSponsor_FundEnforcesCumulativeCap(t *testing.T) {
+	sponsor, txMgr := newTestSponsor(t, big.NewInt(1_000_000), big.NewInt(150), nil)
+	claim := types.Claim{}
+
+	require.NoError(t, sponsor.Fund(context.Background(), claim, big.NewInt(100)))
+	require.Len(t, txMgr.sent, 1)
+
+	// A second fund call for an amount that would be fine on its own (100 < 150) must still be
+	// rejected once it would push the game's cumulative locked bond above the cap.
+	err := sponsor.Fund(context.Background(), claim, big.NewInt(100))
+	require.Error(t, err)
+	require.Len(t, txMgr.sent, 1, "the rejected fund call must not have sent a transaction")
+	require.Equal(t, big.NewInt(100), sponsor.Locked())
+}
+
+func TestTxMgrBondSponsor_FundRejectsInsufficientBalance(t *testing.T) {
+	sponsor, txMgr := newTestSponsor(t, big.NewInt(50), nil, nil)
+
+	err := sponsor.Fund(context.Background(), types.Claim{}, big.NewInt(100))
+	require.Error(t, err)
+	require.Empty(t, txMgr.sent)
+	require.Equal(t, big.NewInt(0), sponsor.Locked())
+}
+
+func TestTxMgrBondSponsor_WithdrawDecrementsLocked(t *testing.T) {
+	sponsor, _ := newTestSponsor(t, big.NewInt(1_000), nil, nil)
+	claim := types.Claim{}
+	require.NoError(t, sponsor.Fund(context.Background(), claim, big.NewInt(100)))
+
+	require.NoError(t, sponsor.Withdraw(context.Background(), claim, big.NewInt(40)))
+
+	require.Equal(t, big.NewInt(60), sponsor.Locked())
+}
+
+func TestTxMgrBondSponsor_WithdrawFloorsAtZero(t *testing.T) {
+	sponsor, _ := newTestSponsor(t, big.NewInt(1_000), nil, nil)
+	claim := types.Claim{}
+	require.NoError(t, sponsor.Fund(context.Background(), claim, big.NewInt(10)))
+
+	require.NoError(t, sponsor.Withdraw(context.Background(), claim, big.NewInt(100)))
+
+	require.Equal(t, big.NewInt(0), sponsor.Locked())
+}
+
+func TestTxMgrBondSponsor_ReleaseAllZeroesLocked(t *testing.T) {
+	sponsor, _ := newTestSponsor(t, big.NewInt(1_000), nil, nil)
+	claim := types.Claim{}
+	require.NoError(t, sponsor.Fund(context.Background(), claim, big.NewInt(100)))
+
+	require.NoError(t, sponsor.ReleaseAll(context.Background()))
+
+	require.Equal(t, big.NewInt(0), sponsor.Locked())
+}
+
+func TestTxMgrBondSponsor_RecordsLockedMetric(t *testing.T) {
+	m := newStubMetrics()
+	sponsor, _ := newTestSponsor(t, big.NewInt(1_000), nil, m)
+	game := common.HexToAddress("0x1")
+
+	require.NoError(t, sponsor.Fund(context.Background(), types.Claim{}, big.NewInt(100)))
+	require.Equal(t, big.NewInt(100), m.locked[game])
+
+	require.NoError(t, sponsor.ReleaseAll(context.Background()))
+	require.Equal(t, big.NewInt(0), m.locked[game])
+}
@@ -0,0 +1,140 @@
+// Package responder implements the component that signs and submits on-chain claim moves for a
+// fault dispute game.
+package responder
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
+	"github.com/ethereum-optimism/optimism/op-service/txmgr"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// BondSponsor funds the ETH bond attached to a claim move from a separately configured account,
+// so the hot key that signs moves never needs to hold a large bonded balance itself.
+type BondSponsor interface {
+	// Fund ensures bondAmount is available to the responder before it submits a move for parent.
+	// It returns an error without sending anything if the sponsor can't cover bondAmount, so the
+	// caller can skip the move rather than submit a transaction that would revert.
+	Fund(ctx context.Context, parent types.Claim, bondAmount *big.Int) error
+	// Withdraw releases bondAmount that was fronted for parent but never spent, because the move
+	// it was meant for was never submitted.
+	Withdraw(ctx context.Context, parent types.Claim, bondAmount *big.Int) error
+	// ReleaseAll stops counting any bond fronted for this game as locked, because the game has
+	// resolved and every bond it posted has since been returned.
+	ReleaseAll(ctx context.Context) error
+	// Locked returns the total ETH currently fronted for this game, so operators can size the
+	// cold wallet that backs the fleet.
+	Locked() *big.Int
+}
+
+// FaultDisputeGame is the subset of the fault dispute game contract bindings the responder needs
+// to build move transactions and look up the bond each move requires.
+type FaultDisputeGame interface {
+	AttackTx(ctx context.Context, parent types.Claim, claim common.Hash) (txmgr.TxCandidate, error)
+	DefendTx(ctx context.Context, parent types.Claim, claim common.Hash) (txmgr.TxCandidate, error)
+	RequiredBond(ctx context.Context, parent types.Claim) (*big.Int, error)
+}
+
+// FaultResponder signs and submits the claim moves (Attack, Defend) for a single fault dispute
+// game.
+type FaultResponder struct {
+	log     log.Logger
+	txMgr   txmgr.TxManager
+	fdgAddr common.Address
+	fdg     FaultDisputeGame
+	sponsor BondSponsor
+}
+
+// Option configures optional behaviour of a FaultResponder.
+type Option func(*FaultResponder)
+
+// WithBondSponsor configures r to have sponsor front the bond for each move, rather than relying
+// on the responder's own account balance.
+func WithBondSponsor(sponsor BondSponsor) Option {
+	return func(r *FaultResponder) {
+		r.sponsor = sponsor
+	}
+}
+
+// NewFaultResponder creates a FaultResponder that signs and submits moves for the game at
+// fdgAddr, using fdg to build move calldata and txMgr to send transactions.
+func NewFaultResponder(logger log.Logger, txMgr txmgr.TxManager, fdgAddr common.Address, fdg FaultDisputeGame, opts ...Option) (*FaultResponder, error) {
+	r := &FaultResponder{
+		log:     logger,
+		txMgr:   txMgr,
+		fdgAddr: fdgAddr,
+		fdg:     fdg,
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r, nil
+}
+
+// Attack submits a claim disputing parent, funding its bond first if a BondSponsor is configured.
+func (r *FaultResponder) Attack(ctx context.Context, parent types.Claim, claim common.Hash) error {
+	candidate, err := r.fdg.AttackTx(ctx, parent, claim)
+	if err != nil {
+		return fmt.Errorf("failed to build attack tx: %w", err)
+	}
+	return r.move(ctx, parent, candidate)
+}
+
+// Defend submits a claim supporting parent, funding its bond first if a BondSponsor is
+// configured.
+func (r *FaultResponder) Defend(ctx context.Context, parent types.Claim, claim common.Hash) error {
+	candidate, err := r.fdg.DefendTx(ctx, parent, claim)
+	if err != nil {
+		return fmt.Errorf("failed to build defend tx: %w", err)
+	}
+	return r.move(ctx, parent, candidate)
+}
+
+func (r *FaultResponder) move(ctx context.Context, parent types.Claim, candidate txmgr.TxCandidate) error {
+	bondAmount, err := r.fund(ctx, parent)
+	if err != nil {
+		return fmt.Errorf("failed to fund bond for move: %w", err)
+	}
+	if _, err := r.txMgr.Send(ctx, candidate); err != nil {
+		// The move never landed on chain, so the bond that was just fronted for it was never
+		// spent. Release it rather than leaving it counted as locked forever.
+		if r.sponsor != nil {
+			if releaseErr := r.sponsor.Withdraw(ctx, parent, bondAmount); releaseErr != nil {
+				r.log.Warn("Failed to release bond for a move that was never submitted", "err", releaseErr)
+			}
+		}
+		return fmt.Errorf("failed to submit move: %w", err)
+	}
+	return nil
+}
+
+// ReleaseAllBonds tells the configured BondSponsor that every bond it has fronted for this game is
+// no longer locked, because the game has resolved and the bonds it posted have since been
+// returned. It should be called once that happens; it is a no-op if no sponsor is configured.
+func (r *FaultResponder) ReleaseAllBonds(ctx context.Context) error {
+	if r.sponsor == nil {
+		return nil
+	}
+	return r.sponsor.ReleaseAll(ctx)
+}
+
+// fund asks the configured BondSponsor to pre-fund the bond required to move against parent,
+// returning the amount fronted so the caller can release it again if the move is never submitted.
+// If no sponsor is configured, the responder funds its own bonds, so this is a no-op.
+func (r *FaultResponder) fund(ctx context.Context, parent types.Claim) (*big.Int, error) {
+	if r.sponsor == nil {
+		return nil, nil
+	}
+	bondAmount, err := r.fdg.RequiredBond(ctx, parent)
+	if err != nil {
+		return nil, fmt.Errorf("failed to determine required bond: %w", err)
+	}
+	if err := r.sponsor.Fund(ctx, parent, bondAmount); err != nil {
+		return nil, err
+	}
+	return bondAmount, nil
+}
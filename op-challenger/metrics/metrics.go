@@ -0,0 +1,52 @@
+// Package metrics defines the metrics recorded by op-challenger.
+package metrics
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	gameTypes "github.com/ethereum-optimism/optimism/op-challenger/game/types"
+)
+
+// Namespace is the prefix applied to every metric this package registers.
+const Namespace = "op_challenger"
+
+// Metricer is implemented by anything that can record op-challenger metrics. It is passed down to
+// the fault dispute game player and agent so they can report progress without depending on a
+// concrete metrics backend.
+type Metricer interface {
+	// RecordGameStatus records the latest known status of the game at addr.
+	RecordGameStatus(addr common.Address, status gameTypes.GameStatus)
+	// RecordClaimCount records the number of claims posted to the game at addr.
+	RecordClaimCount(addr common.Address, count uint64)
+	// RecordActDuration records how long a single Act call took for the game at addr.
+	RecordActDuration(addr common.Address, dur time.Duration)
+	// RecordActError records that Act failed for the game at addr, labelled with a coarse kind
+	// ("act_failed", or "reorg_cancelled" when an L1 reorg cancelled it) so operators can tell
+	// failure modes apart.
+	RecordActError(addr common.Address, kind string)
+	// RecordBondsLocked records the ETH currently fronted by the bond sponsor for the game at
+	// addr. Summed across games, this gives operators the fleet-wide total so they can size the
+	// cold wallet that backs it.
+	RecordBondsLocked(addr common.Address, amount *big.Int)
+	// RecordReorgDepth records the depth, in blocks, of a detected L1 reorg.
+	RecordReorgDepth(depth uint64)
+	// RecordReorgAffectedGames records how many tracked games were notified of a detected L1 reorg.
+	RecordReorgAffectedGames(count int)
+}
+
+// NoopMetrics discards everything recorded through it. It's useful for callers that don't expose
+// a metrics endpoint, such as one-off tooling.
+var NoopMetrics Metricer = new(noopMetricer)
+
+type noopMetricer struct{}
+
+func (*noopMetricer) RecordGameStatus(common.Address, gameTypes.GameStatus) {}
+func (*noopMetricer) RecordClaimCount(common.Address, uint64)               {}
+func (*noopMetricer) RecordActDuration(common.Address, time.Duration)       {}
+func (*noopMetricer) RecordActError(common.Address, string)                {}
+func (*noopMetricer) RecordBondsLocked(common.Address, *big.Int)           {}
+func (*noopMetricer) RecordReorgDepth(uint64)                               {}
+func (*noopMetricer) RecordReorgAffectedGames(int)                         {}
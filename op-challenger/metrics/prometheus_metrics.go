@@ -0,0 +1,164 @@
+package metrics
+
+import (
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/prometheus/client_golang/prometheus"
+
+	gameTypes "github.com/ethereum-optimism/optimism/op-challenger/game/types"
+)
+
+// PrometheusMetrics implements Metricer, exposing per-game series labelled by the game's address.
+// Label cardinality is kept near maxTrackedGames: once more than that many games have live series,
+// the least-recently-touched resolved game is evicted to make room, rather than letting the
+// address label grow without bound as a fleet churns through thousands of games over time.
+// In-progress games are never evicted, so while more than maxTrackedGames are in progress the
+// count exceeds it.
+type PrometheusMetrics struct {
+	log                log.Logger
+	gameStatus         *prometheus.GaugeVec
+	claimCount         *prometheus.GaugeVec
+	actDuration        *prometheus.HistogramVec
+	actErrors          *prometheus.CounterVec
+	bondsLocked        *prometheus.GaugeVec
+	reorgDepth         prometheus.Histogram
+	reorgAffectedGames prometheus.Gauge
+
+	mu              sync.Mutex
+	maxTrackedGames int
+	resolved        map[common.Address]bool
+	touchOrder      []common.Address // least-recently-touched first
+}
+
+// NewPrometheusMetrics registers the per-game series on registry. maxTrackedGames is the number
+// of distinct game addresses above which resolved games' series are evicted; zero or less
+// disables eviction.
+func NewPrometheusMetrics(logger log.Logger, registry *prometheus.Registry, maxTrackedGames int) *PrometheusMetrics {
+	m := &PrometheusMetrics{
+		log: logger,
+		gameStatus: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "game_status",
+			Help:      "Status of a tracked game (0=in progress, 1=challenger won, 2=defender won)",
+		}, []string{"game"}),
+		claimCount: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "game_claim_count",
+			Help:      "Number of claims posted to a tracked game",
+		}, []string{"game"}),
+		actDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: Namespace,
+			Name:      "game_act_duration_seconds",
+			Help:      "Time taken to perform a single act on a tracked game",
+		}, []string{"game"}),
+		actErrors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: Namespace,
+			Name:      "game_act_errors_total",
+			Help:      "Count of act errors for a tracked game, labelled by error kind",
+		}, []string{"game", "kind"}),
+		bondsLocked: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "bonds_locked_wei",
+			Help:      "ETH, in wei, currently fronted by the bond sponsor for a tracked game. Sum across games for the fleet-wide total.",
+		}, []string{"game"}),
+		reorgDepth: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Namespace: Namespace,
+			Name:      "reorg_depth",
+			Help:      "Depth, in blocks, of each detected L1 reorg",
+			Buckets:   []float64{1, 2, 4, 8, 16, 32, 64},
+		}),
+		reorgAffectedGames: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "reorg_affected_games",
+			Help:      "Number of tracked games notified of the most recently detected L1 reorg",
+		}),
+		maxTrackedGames: maxTrackedGames,
+		resolved:        make(map[common.Address]bool),
+	}
+	registry.MustRegister(m.gameStatus, m.claimCount, m.actDuration, m.actErrors, m.bondsLocked, m.reorgDepth, m.reorgAffectedGames)
+	return m
+}
+
+// RecordReorgDepth implements reorg.Metrics.
+func (m *PrometheusMetrics) RecordReorgDepth(depth uint64) {
+	m.reorgDepth.Observe(float64(depth))
+}
+
+// RecordReorgAffectedGames implements reorg.Metrics.
+func (m *PrometheusMetrics) RecordReorgAffectedGames(count int) {
+	m.reorgAffectedGames.Set(float64(count))
+}
+
+func (m *PrometheusMetrics) RecordBondsLocked(addr common.Address, amount *big.Int) {
+	m.touch(addr)
+	f, _ := new(big.Float).SetInt(amount).Float64()
+	m.bondsLocked.WithLabelValues(addr.Hex()).Set(f)
+}
+
+func (m *PrometheusMetrics) RecordGameStatus(addr common.Address, status gameTypes.GameStatus) {
+	m.touch(addr)
+	m.gameStatus.WithLabelValues(addr.Hex()).Set(float64(status))
+	if status != gameTypes.GameStatusInProgress {
+		m.mu.Lock()
+		m.resolved[addr] = true
+		m.mu.Unlock()
+	}
+}
+
+func (m *PrometheusMetrics) RecordClaimCount(addr common.Address, count uint64) {
+	m.touch(addr)
+	m.claimCount.WithLabelValues(addr.Hex()).Set(float64(count))
+}
+
+func (m *PrometheusMetrics) RecordActDuration(addr common.Address, dur time.Duration) {
+	m.touch(addr)
+	m.actDuration.WithLabelValues(addr.Hex()).Observe(dur.Seconds())
+}
+
+func (m *PrometheusMetrics) RecordActError(addr common.Address, kind string) {
+	m.touch(addr)
+	m.actErrors.WithLabelValues(addr.Hex(), kind).Inc()
+}
+
+// touch marks addr as the most-recently-used tracked game, evicting the least-recently-touched
+// resolved game if this pushes us over maxTrackedGames.
+func (m *PrometheusMetrics) touch(addr common.Address) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for i, a := range m.touchOrder {
+		if a == addr {
+			m.touchOrder = append(m.touchOrder[:i], m.touchOrder[i+1:]...)
+			break
+		}
+	}
+	m.touchOrder = append(m.touchOrder, addr)
+	if m.maxTrackedGames <= 0 || len(m.touchOrder) <= m.maxTrackedGames {
+		return
+	}
+	for i, candidate := range m.touchOrder {
+		if !m.resolved[candidate] {
+			continue
+		}
+		m.touchOrder = append(m.touchOrder[:i], m.touchOrder[i+1:]...)
+		delete(m.resolved, candidate)
+		m.evictLabels(candidate)
+		return
+	}
+	// Every tracked game is still in progress, so there's nothing resolved to evict: the cap
+	// isn't actually holding and the address label cardinality will keep growing until one does
+	// resolve.
+	m.log.Warn("Tracked game count exceeds max-tracked-games but none are resolved; unable to evict",
+		"tracked", len(m.touchOrder), "maxTrackedGames", m.maxTrackedGames)
+}
+
+func (m *PrometheusMetrics) evictLabels(addr common.Address) {
+	m.gameStatus.DeleteLabelValues(addr.Hex())
+	m.claimCount.DeleteLabelValues(addr.Hex())
+	m.actDuration.DeleteLabelValues(addr.Hex())
+	m.actErrors.DeletePartialMatch(prometheus.Labels{"game": addr.Hex()})
+	m.bondsLocked.DeleteLabelValues(addr.Hex())
+}
@@ -0,0 +1,63 @@
+package metrics
+
+import (
+	"math/big"
+	"testing"
+
+	gameTypes "github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrometheusMetrics_EvictsLeastRecentlyTouchedResolvedGame(t *testing.T) {
+	m := NewPrometheusMetrics(log.New(), prometheus.NewRegistry(), 2)
+	gameA := common.HexToAddress("0xa")
+	gameB := common.HexToAddress("0xb")
+	gameC := common.HexToAddress("0xc")
+
+	m.RecordGameStatus(gameA, gameTypes.GameStatusChallengerWon)
+	m.RecordGameStatus(gameB, gameTypes.GameStatusInProgress)
+	require.Equal(t, float64(1), testutil.ToFloat64(m.gameStatus.WithLabelValues(gameA.Hex())))
+
+	// Adding a third game exceeds maxTrackedGames (2), so the least-recently-touched resolved game
+	// (gameA) should be evicted to make room, while the in-progress gameB is kept.
+	m.RecordGameStatus(gameC, gameTypes.GameStatusInProgress)
+
+	require.Equal(t, float64(0), testutil.ToFloat64(m.gameStatus.WithLabelValues(gameA.Hex())),
+		"evicted game's series should reset to the zero value for a fresh label")
+}
+
+func TestPrometheusMetrics_NoEvictionWhenNothingResolved(t *testing.T) {
+	m := NewPrometheusMetrics(log.New(), prometheus.NewRegistry(), 1)
+	gameA := common.HexToAddress("0xa")
+	gameB := common.HexToAddress("0xb")
+
+	m.RecordGameStatus(gameA, gameTypes.GameStatusInProgress)
+	m.RecordGameStatus(gameB, gameTypes.GameStatusInProgress)
+
+	// Neither game is resolved, so there's nothing to evict; both series must still be live.
+	require.Equal(t, float64(0), testutil.ToFloat64(m.gameStatus.WithLabelValues(gameA.Hex())))
+	require.Equal(t, float64(0), testutil.ToFloat64(m.gameStatus.WithLabelValues(gameB.Hex())))
+	require.Len(t, m.touchOrder, 2)
+}
+
+func TestPrometheusMetrics_RecordBondsLocked(t *testing.T) {
+	m := NewPrometheusMetrics(log.New(), prometheus.NewRegistry(), 10)
+	game := common.HexToAddress("0xa")
+
+	m.RecordBondsLocked(game, big.NewInt(5_000))
+
+	require.Equal(t, float64(5_000), testutil.ToFloat64(m.bondsLocked.WithLabelValues(game.Hex())))
+}
+
+func TestPrometheusMetrics_RecordReorgMetrics(t *testing.T) {
+	m := NewPrometheusMetrics(log.New(), prometheus.NewRegistry(), 10)
+
+	m.RecordReorgDepth(3)
+	m.RecordReorgAffectedGames(2)
+
+	require.Equal(t, float64(2), testutil.ToFloat64(m.reorgAffectedGames))
+}